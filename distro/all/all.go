@@ -11,18 +11,25 @@ import (
 	// Default commander and all its services. This is an optional feature.
 	_ "v2ray.com/core/app/commander"
 	_ "v2ray.com/core/app/log/command"
+	_ "v2ray.com/core/app/policy/command"
 	_ "v2ray.com/core/app/proxyman/command"
+	_ "v2ray.com/core/app/quota/command"
+	_ "v2ray.com/core/app/router/command"
 	_ "v2ray.com/core/app/stats/command"
 
 	// Other optional features.
 	_ "v2ray.com/core/app/dns"
+	_ "v2ray.com/core/app/dns/fakedns"
 	_ "v2ray.com/core/app/log"
+	_ "v2ray.com/core/app/metrics"
 	_ "v2ray.com/core/app/policy"
+	_ "v2ray.com/core/app/quota"
 	_ "v2ray.com/core/app/router"
 	_ "v2ray.com/core/app/stats"
 
 	// Inbound and outbound proxies.
 	_ "v2ray.com/core/proxy/blackhole"
+	_ "v2ray.com/core/proxy/dns"
 	_ "v2ray.com/core/proxy/dokodemo"
 	_ "v2ray.com/core/proxy/freedom"
 	_ "v2ray.com/core/proxy/http"
@@ -30,6 +37,7 @@ import (
 	_ "v2ray.com/core/proxy/socks"
 	_ "v2ray.com/core/proxy/vmess/inbound"
 	_ "v2ray.com/core/proxy/vmess/outbound"
+	_ "v2ray.com/core/proxy/wireguard"
 
 	// Transports
 	_ "v2ray.com/core/transport/internet/domainsocket"